@@ -0,0 +1,109 @@
+package dotmac
+
+import (
+    "errors"
+    "sync"
+    "time"
+)
+
+// ErrCircuitOpen is returned when a request is rejected because the circuit
+// breaker for its host is open.
+var ErrCircuitOpen = errors.New("dotmac: circuit breaker open")
+
+// beforeHookError wraps an error returned by Client's OnBeforeRequest hook
+// (circuit breaker rejection, rate-limit wait, token refresh), letting the
+// OnError hook tell "never reached the wire" rejections apart from genuine
+// transport failures via errors.As.
+type beforeHookError struct {
+    err error
+}
+
+func (e *beforeHookError) Error() string { return e.err.Error() }
+func (e *beforeHookError) Unwrap() error { return e.err }
+
+// BreakerPolicy configures the per-host circuit breaker installed on every
+// Client. A zero value falls back to sensible defaults rather than
+// disabling the breaker.
+type BreakerPolicy struct {
+    // FailureThreshold is the number of consecutive failures that trips the
+    // breaker open. Defaults to 5.
+    FailureThreshold int
+    // OpenDuration is how long the breaker stays open before it turns
+    // half-open and lets requests through again. Defaults to 30s.
+    OpenDuration time.Duration
+}
+
+type breakerState int
+
+const (
+    breakerClosed breakerState = iota
+    breakerOpen
+    breakerHalfOpen
+)
+
+// circuitBreaker is a minimal per-host circuit breaker: FailureThreshold
+// consecutive failures trips it open for OpenDuration, after which it turns
+// half-open and the next recorded result closes it again or reopens it.
+// Half-open does not limit traffic to a single probe: every request that
+// arrives before that result is recorded is let through.
+type circuitBreaker struct {
+    policy BreakerPolicy
+
+    mu       sync.Mutex
+    state    breakerState
+    failures int
+    openedAt time.Time
+}
+
+func newCircuitBreaker(policy BreakerPolicy) *circuitBreaker {
+    if policy.FailureThreshold <= 0 {
+        policy.FailureThreshold = 5
+    }
+    if policy.OpenDuration <= 0 {
+        policy.OpenDuration = 30 * time.Second
+    }
+    return &circuitBreaker{policy: policy, state: breakerClosed}
+}
+
+// allow reports whether a request may proceed, transitioning an open
+// breaker to half-open once policy.OpenDuration has elapsed.
+func (b *circuitBreaker) allow() bool {
+    b.mu.Lock()
+    defer b.mu.Unlock()
+
+    if b.state == breakerOpen {
+        if time.Since(b.openedAt) < b.policy.OpenDuration {
+            return false
+        }
+        b.state = breakerHalfOpen
+    }
+    return true
+}
+
+// recordSuccess closes the breaker and resets its failure count.
+func (b *circuitBreaker) recordSuccess() {
+    b.mu.Lock()
+    defer b.mu.Unlock()
+    b.state = breakerClosed
+    b.failures = 0
+}
+
+// recordFailure increments the failure count, tripping the breaker open
+// once it reaches policy.FailureThreshold. A failed half-open probe reopens
+// the breaker immediately.
+func (b *circuitBreaker) recordFailure() {
+    b.mu.Lock()
+    defer b.mu.Unlock()
+
+    if b.state == breakerHalfOpen {
+        b.state = breakerOpen
+        b.openedAt = time.Now()
+        return
+    }
+
+    b.failures++
+    if b.failures >= b.policy.FailureThreshold {
+        b.state = breakerOpen
+        b.openedAt = time.Now()
+    }
+}
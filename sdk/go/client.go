@@ -1,9 +1,18 @@
 package dotmac
 
 import (
+    "context"
+    "errors"
     "fmt"
+    "log/slog"
+    "net/http"
+    "net/url"
+    "sync"
     "time"
+
     "github.com/go-resty/resty/v2"
+    "go.opentelemetry.io/otel/metric"
+    "go.opentelemetry.io/otel/trace"
 )
 
 // Config represents the SDK configuration
@@ -12,6 +21,32 @@ type Config struct {
     APIKey      string
     AccessToken string
     Timeout     time.Duration
+
+    // MaxRetries is the number of automatic retry attempts for failed
+    // requests. 0 (the default) disables retries entirely.
+    MaxRetries   int
+    RetryBackoff RetryBackoff
+    RateLimit    RateLimit
+    Breaker      BreakerPolicy
+
+    // OAuth2 configures automatic access-token refresh using the
+    // refresh_token grant. Leave TokenURL/RefreshToken empty to manage
+    // tokens manually via AccessToken or Client.SetAccessToken instead.
+    OAuth2 OAuth2Config
+    // OnTokenRefresh is called with the rotated access and refresh tokens
+    // every time OAuth2 refreshes, so applications can persist them.
+    OnTokenRefresh func(accessToken, refreshToken string)
+
+    // Tracer and Meter enable OpenTelemetry tracing/metrics for every
+    // request when set. Leave nil to disable tracing/metrics entirely.
+    Tracer trace.TracerProvider
+    Meter  metric.MeterProvider
+    // Logger enables structured request/response logging at debug level
+    // (warn on error) when set.
+    Logger *slog.Logger
+    // RedactFields lists JSON body field names masked (e.g. "primary_email",
+    // "primary_phone") before a request body is written to Logger.
+    RedactFields []string
 }
 
 // Client is the main DotMac API client
@@ -21,6 +56,17 @@ type Client struct {
     Customers *CustomerService
     Tickets   *TicketService
     Invoices  *InvoiceService
+    Webhooks  *WebhookService
+
+    limiter *tokenBucket
+
+    breakersMu sync.Mutex
+    breakers   map[string]*circuitBreaker
+
+    tokenMu     sync.Mutex
+    tokenSource TokenSource
+
+    obs *observability
 }
 
 // NewClient creates a new DotMac API client
@@ -43,37 +89,142 @@ func NewClient(config Config) *Client {
         httpClient.SetHeader("Authorization", "Bearer "+config.AccessToken)
     }
 
+    configureRetry(httpClient, config.MaxRetries, config.RetryBackoff)
+
     client := &Client{
-        config: config,
-        http:   httpClient,
+        config:   config,
+        http:     httpClient,
+        breakers: make(map[string]*circuitBreaker),
+        obs:      newObservability(config),
+    }
+
+    if config.RateLimit.RequestsPerSecond > 0 {
+        client.limiter = newTokenBucket(config.RateLimit)
     }
 
+    if config.OAuth2.TokenURL != "" && config.OAuth2.RefreshToken != "" {
+        ts := newRefreshingTokenSource(config.OAuth2)
+        ts.onRefresh = config.OnTokenRefresh
+        client.tokenSource = ts
+    }
+
+    httpClient.OnBeforeRequest(func(rc *resty.Client, req *resty.Request) error {
+        if client.limiter != nil {
+            if err := client.limiter.wait(req.Context()); err != nil {
+                return &beforeHookError{err}
+            }
+        }
+        if !client.breakerFor(rc.BaseURL).allow() {
+            return &beforeHookError{ErrCircuitOpen}
+        }
+        if token, err := client.CurrentToken(req.Context()); err != nil {
+            return &beforeHookError{fmt.Errorf("dotmac: refresh access token: %w", err)}
+        } else if token != "" {
+            req.SetAuthToken(token)
+        }
+        return nil
+    })
+    httpClient.OnAfterResponse(func(rc *resty.Client, resp *resty.Response) error {
+        breaker := client.breakerFor(rc.BaseURL)
+        if resp.IsError() {
+            breaker.recordFailure()
+        } else {
+            breaker.recordSuccess()
+        }
+        return nil
+    })
+    httpClient.OnError(func(req *resty.Request, err error) {
+        // Before-hook rejections (circuit open, rate-limit wait, token
+        // refresh) never reached the wire, so they must not count against
+        // the breaker — doing so would keep resetting openedAt and the
+        // breaker would never heal. Only genuine transport failures land
+        // here once unwrapped.
+        var hookErr *beforeHookError
+        if errors.As(err, &hookErr) {
+            return
+        }
+        client.breakerFor(client.http.BaseURL).recordFailure()
+    })
+
     // Initialize services
     client.Customers = &CustomerService{client: client}
     client.Tickets = &TicketService{client: client}
     client.Invoices = &InvoiceService{client: client}
+    client.Webhooks = &WebhookService{client: client}
 
     return client
 }
 
-// Customer represents a customer entity
-type Customer struct {
-    ID             string    `json:"id"`
-    CustomerNumber string    `json:"customer_number"`
-    DisplayName    string    `json:"display_name"`
-    CustomerType   string    `json:"customer_type"`
-    State          string    `json:"state"`
-    CreatedAt      time.Time `json:"created_at"`
-    UpdatedAt      time.Time `json:"updated_at"`
+// breakerFor returns the circuit breaker for the given base URL's host,
+// creating one lazily using config.Breaker on first use.
+func (c *Client) breakerFor(baseURL string) *circuitBreaker {
+    host := baseURL
+    if u, err := url.Parse(baseURL); err == nil && u.Host != "" {
+        host = u.Host
+    }
+
+    c.breakersMu.Lock()
+    defer c.breakersMu.Unlock()
+
+    b, ok := c.breakers[host]
+    if !ok {
+        b = newCircuitBreaker(c.config.Breaker)
+        c.breakers[host] = b
+    }
+    return b
+}
+
+// SetAccessToken manually sets the access token attached to subsequent
+// requests, replacing any configured OAuth2 TokenSource. Useful when an
+// application manages its own token rotation outside of Config.OAuth2.
+func (c *Client) SetAccessToken(token string) {
+    c.tokenMu.Lock()
+    defer c.tokenMu.Unlock()
+    c.tokenSource = staticTokenSource(token)
+}
+
+// CurrentToken returns the access token that will be attached to the next
+// request, refreshing it first if an OAuth2 TokenSource is configured and
+// the cached token is stale. It returns the static Config.AccessToken (or
+// "" if none was set) when no TokenSource is configured.
+func (c *Client) CurrentToken(ctx context.Context) (string, error) {
+    c.tokenMu.Lock()
+    ts := c.tokenSource
+    c.tokenMu.Unlock()
+
+    if ts == nil {
+        return c.config.AccessToken, nil
+    }
+    return ts.Token(ctx)
+}
+
+// Use registers custom resty request or response middleware on the
+// underlying HTTP client, letting callers add tracing, logging, or custom
+// auth without forking the SDK. middleware may be a resty.RequestMiddleware/
+// resty.ResponseMiddleware value or simply a func with the same underlying
+// signature (func(*resty.Client, *resty.Request) error or
+// func(*resty.Client, *resty.Response) error) — both are accepted since a
+// type switch alone would only match the named types, not plain closures.
+func (c *Client) Use(middleware any) error {
+    switch m := middleware.(type) {
+    case resty.RequestMiddleware:
+        c.http.OnBeforeRequest(m)
+    case func(*resty.Client, *resty.Request) error:
+        c.http.OnBeforeRequest(m)
+    case resty.ResponseMiddleware:
+        c.http.OnAfterResponse(m)
+    case func(*resty.Client, *resty.Response) error:
+        c.http.OnAfterResponse(m)
+    default:
+        return fmt.Errorf("dotmac: Use requires a resty.RequestMiddleware or resty.ResponseMiddleware, got %T", middleware)
+    }
+    return nil
 }
 
-// CreateCustomerRequest represents the request to create a customer
-type CreateCustomerRequest struct {
-    DisplayName    string                 `json:"display_name"`
-    CustomerType   string                 `json:"customer_type"`
-    PrimaryEmail   string                 `json:"primary_email"`
-    PrimaryPhone   string                 `json:"primary_phone"`
-    ServiceAddress map[string]interface{} `json:"service_address,omitempty"`
+// SetTransport overrides the underlying HTTP transport, e.g. to record or
+// replay fixtures in tests. See the dotmactest subpackage.
+func (c *Client) SetTransport(transport http.RoundTripper) {
+    c.http.SetTransport(transport)
 }
 
 // PaginatedResponse represents a paginated API response
@@ -86,101 +237,3 @@ type PaginatedResponse[T any] struct {
     HasNext bool `json:"has_next"`
     HasPrev bool `json:"has_prev"`
 }
-
-// CustomerService handles customer-related operations
-type CustomerService struct {
-    client *Client
-}
-
-// Create creates a new customer
-func (s *CustomerService) Create(req CreateCustomerRequest) (*Customer, error) {
-    var customer Customer
-    _, err := s.client.http.R().
-        SetBody(req).
-        SetResult(&customer).
-        Post("/api/v1/customers")
-    return &customer, err
-}
-
-// Get retrieves a customer by ID
-func (s *CustomerService) Get(customerID string) (*Customer, error) {
-    var customer Customer
-    _, err := s.client.http.R().
-        SetResult(&customer).
-        Get(fmt.Sprintf("/api/v1/customers/%s", customerID))
-    return &customer, err
-}
-
-// List retrieves a paginated list of customers
-func (s *CustomerService) List(page, limit int) (*PaginatedResponse[Customer], error) {
-    var response PaginatedResponse[Customer]
-    _, err := s.client.http.R().
-        SetQueryParam("page", fmt.Sprintf("%d", page)).
-        SetQueryParam("limit", fmt.Sprintf("%d", limit)).
-        SetResult(&response).
-        Get("/api/v1/customers")
-    return &response, err
-}
-
-// TicketService handles ticket-related operations
-type TicketService struct {
-    client *Client
-}
-
-// Ticket represents a support ticket
-type Ticket struct {
-    ID         string    `json:"id"`
-    CustomerID string    `json:"customer_id"`
-    Subject    string    `json:"subject"`
-    Status     string    `json:"status"`
-    Priority   string    `json:"priority"`
-    CreatedAt  time.Time `json:"created_at"`
-    UpdatedAt  time.Time `json:"updated_at"`
-}
-
-// CreateTicketRequest represents the request to create a ticket
-type CreateTicketRequest struct {
-    CustomerID  string `json:"customer_id"`
-    Subject     string `json:"subject"`
-    Description string `json:"description"`
-    Priority    string `json:"priority,omitempty"`
-}
-
-// Create creates a new support ticket
-func (s *TicketService) Create(req CreateTicketRequest) (*Ticket, error) {
-    var ticket Ticket
-    _, err := s.client.http.R().
-        SetBody(req).
-        SetResult(&ticket).
-        Post("/api/v1/tickets")
-    return &ticket, err
-}
-
-// InvoiceService handles invoice-related operations
-type InvoiceService struct {
-    client *Client
-}
-
-// Invoice represents an invoice
-type Invoice struct {
-    ID         string    `json:"id"`
-    CustomerID string    `json:"customer_id"`
-    Amount     float64   `json:"amount"`
-    Currency   string    `json:"currency"`
-    Status     string    `json:"status"`
-    DueDate    string    `json:"due_date"`
-    CreatedAt  time.Time `json:"created_at"`
-}
-
-// List retrieves a paginated list of invoices
-func (s *InvoiceService) List(customerID string) (*PaginatedResponse[Invoice], error) {
-    var response PaginatedResponse[Invoice]
-    req := s.client.http.R().SetResult(&response)
-    
-    if customerID != "" {
-        req.SetQueryParam("customer_id", customerID)
-    }
-    
-    _, err := req.Get("/api/v1/invoices")
-    return &response, err
-}
@@ -0,0 +1,54 @@
+package dotmac
+
+import (
+    "context"
+    "fmt"
+    "iter"
+    "time"
+)
+
+// Customer represents a customer entity
+type Customer struct {
+    ID             string    `json:"id"`
+    CustomerNumber string    `json:"customer_number"`
+    DisplayName    string    `json:"display_name"`
+    CustomerType   string    `json:"customer_type"`
+    State          string    `json:"state"`
+    CreatedAt      time.Time `json:"created_at"`
+    UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// CreateCustomerRequest represents the request to create a customer
+type CreateCustomerRequest struct {
+    DisplayName    string                 `json:"display_name"`
+    CustomerType   string                 `json:"customer_type"`
+    PrimaryEmail   string                 `json:"primary_email"`
+    PrimaryPhone   string                 `json:"primary_phone"`
+    ServiceAddress map[string]interface{} `json:"service_address,omitempty"`
+}
+
+// CustomerService handles customer-related operations
+type CustomerService struct {
+    client *Client
+}
+
+// Create creates a new customer
+func (s *CustomerService) Create(ctx context.Context, req CreateCustomerRequest) (*Customer, error) {
+    return doPOSTRequest[Customer](ctx, s.client, "customers.create", "/api/v1/customers", req)
+}
+
+// Get retrieves a customer by ID
+func (s *CustomerService) Get(ctx context.Context, customerID string) (*Customer, error) {
+    return doGETRequest[Customer](ctx, s.client, "customers.get", fmt.Sprintf("/api/v1/customers/%s", customerID))
+}
+
+// List retrieves a single page of customers
+func (s *CustomerService) List(ctx context.Context, opts ListOptions) (*PaginatedResponse[Customer], error) {
+    return doGETListRequest[Customer](ctx, s.client, "customers.list", "/api/v1/customers", opts)
+}
+
+// ListAll returns an iterator over every customer matching opts, fetching
+// additional pages from the API as the iteration advances.
+func (s *CustomerService) ListAll(ctx context.Context, opts ListOptions) iter.Seq2[Customer, error] {
+    return listAll(ctx, opts, s.List)
+}
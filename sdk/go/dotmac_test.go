@@ -0,0 +1,241 @@
+package dotmac_test
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "io"
+    "net/http"
+    "testing"
+
+    dotmac "github.com/michaelayoade/dotmac_framework/sdk/go"
+    "github.com/michaelayoade/dotmac_framework/sdk/go/dotmactest"
+)
+
+func TestCustomerLifecycle(t *testing.T) {
+    client, _ := dotmactest.NewTestClient(t)
+    ctx := context.Background()
+
+    created, err := client.Customers.Create(ctx, dotmac.CreateCustomerRequest{
+        DisplayName:  "Ada Lovelace",
+        CustomerType: "residential",
+        PrimaryEmail: "ada@example.com",
+    })
+    if err != nil {
+        t.Fatalf("Create: %v", err)
+    }
+    if created.DisplayName != "Ada Lovelace" {
+        t.Fatalf("DisplayName = %q, want %q", created.DisplayName, "Ada Lovelace")
+    }
+
+    got, err := client.Customers.Get(ctx, created.ID)
+    if err != nil {
+        t.Fatalf("Get: %v", err)
+    }
+    if got.ID != created.ID {
+        t.Fatalf("Get returned ID %q, want %q", got.ID, created.ID)
+    }
+
+    page, err := client.Customers.List(ctx, dotmac.ListOptions{})
+    if err != nil {
+        t.Fatalf("List: %v", err)
+    }
+    if page.Total != 1 {
+        t.Fatalf("List Total = %d, want 1", page.Total)
+    }
+
+    if _, err := client.Customers.Get(ctx, "does-not-exist"); err == nil {
+        t.Fatal("Get with unknown ID: want error, got nil")
+    }
+}
+
+func TestCreateCustomerValidationErrorIsTypedAPIError(t *testing.T) {
+    client, _ := dotmactest.NewTestClient(t)
+    ctx := context.Background()
+
+    _, err := client.Customers.Create(ctx, dotmac.CreateCustomerRequest{
+        CustomerType: "residential",
+    })
+    if err == nil {
+        t.Fatal("Create with missing display_name: want error, got nil")
+    }
+
+    var apiErr *dotmac.APIError
+    if !errors.As(err, &apiErr) {
+        t.Fatalf("err = %v (%T), want *dotmac.APIError", err, err)
+    }
+    if apiErr.StatusCode != http.StatusUnprocessableEntity {
+        t.Fatalf("StatusCode = %d, want %d", apiErr.StatusCode, http.StatusUnprocessableEntity)
+    }
+    if apiErr.Code != "validation_failed" {
+        t.Fatalf("Code = %q, want %q", apiErr.Code, "validation_failed")
+    }
+    if apiErr.RequestID != "req_validation_1" {
+        t.Fatalf("RequestID = %q, want %q", apiErr.RequestID, "req_validation_1")
+    }
+    if len(apiErr.Errors) != 1 || apiErr.Errors[0].Field != "display_name" {
+        t.Fatalf("Errors = %+v, want a single display_name field error", apiErr.Errors)
+    }
+}
+
+func TestTicketLifecycle(t *testing.T) {
+    client, _ := dotmactest.NewTestClient(t)
+    ctx := context.Background()
+
+    ticket, err := client.Tickets.Create(ctx, dotmac.CreateTicketRequest{
+        CustomerID: "cust_1",
+        Subject:    "Router not connecting",
+        Priority:   "high",
+    })
+    if err != nil {
+        t.Fatalf("Create: %v", err)
+    }
+
+    updated, err := client.Tickets.Update(ctx, ticket.ID, dotmac.UpdateTicketRequest{Priority: "urgent"})
+    if err != nil {
+        t.Fatalf("Update: %v", err)
+    }
+    if updated.Priority != "urgent" {
+        t.Fatalf("Priority = %q, want %q", updated.Priority, "urgent")
+    }
+
+    if _, err := client.Tickets.AddComment(ctx, ticket.ID, dotmac.AddCommentRequest{Body: "Looking into it"}); err != nil {
+        t.Fatalf("AddComment: %v", err)
+    }
+    if _, err := client.Tickets.AssignAgent(ctx, ticket.ID, "agent_7"); err != nil {
+        t.Fatalf("AssignAgent: %v", err)
+    }
+    changed, err := client.Tickets.ChangeStatus(ctx, ticket.ID, "resolved")
+    if err != nil {
+        t.Fatalf("ChangeStatus: %v", err)
+    }
+    if changed.Status != "resolved" {
+        t.Fatalf("Status = %q, want %q", changed.Status, "resolved")
+    }
+
+    if err := client.Tickets.Delete(ctx, ticket.ID); err != nil {
+        t.Fatalf("Delete: %v", err)
+    }
+    if _, err := client.Tickets.Get(ctx, ticket.ID); err == nil {
+        t.Fatal("Get after Delete: want error, got nil")
+    }
+}
+
+func TestInvoiceLifecycle(t *testing.T) {
+    client, fs := dotmactest.NewTestClient(t)
+    ctx := context.Background()
+
+    fs.SeedInvoice(dotmac.Invoice{ID: "inv_1", CustomerID: "cust_1", Amount: 49.99, Status: "open"})
+
+    inv, err := client.Invoices.Get(ctx, "inv_1")
+    if err != nil {
+        t.Fatalf("Get: %v", err)
+    }
+
+    paid, err := client.Invoices.MarkPaid(ctx, inv.ID)
+    if err != nil {
+        t.Fatalf("MarkPaid: %v", err)
+    }
+    if paid.Status != "paid" {
+        t.Fatalf("Status = %q, want %q", paid.Status, "paid")
+    }
+
+    page, err := client.Invoices.List(ctx, "cust_1", dotmac.ListOptions{})
+    if err != nil {
+        t.Fatalf("List: %v", err)
+    }
+    if page.Total != 1 {
+        t.Fatalf("List Total = %d, want 1", page.Total)
+    }
+
+    voided, err := client.Invoices.Void(ctx, inv.ID)
+    if err != nil {
+        t.Fatalf("Void: %v", err)
+    }
+    if voided.Status != "void" {
+        t.Fatalf("Status = %q, want %q", voided.Status, "void")
+    }
+
+    updated, err := client.Invoices.Update(ctx, inv.ID, dotmac.UpdateInvoiceRequest{DueDate: "2026-12-01"})
+    if err != nil {
+        t.Fatalf("Update: %v", err)
+    }
+    if updated.DueDate != "2026-12-01" {
+        t.Fatalf("DueDate = %q, want %q", updated.DueDate, "2026-12-01")
+    }
+
+    pdf, err := client.Invoices.DownloadPDF(ctx, inv.ID)
+    if err != nil {
+        t.Fatalf("DownloadPDF: %v", err)
+    }
+    defer pdf.Close()
+    body, err := io.ReadAll(pdf)
+    if err != nil {
+        t.Fatalf("read PDF body: %v", err)
+    }
+    if len(body) == 0 {
+        t.Fatal("DownloadPDF returned an empty body")
+    }
+
+    if err := client.Invoices.Delete(ctx, inv.ID); err != nil {
+        t.Fatalf("Delete: %v", err)
+    }
+    if _, err := client.Invoices.Get(ctx, inv.ID); err == nil {
+        t.Fatal("Get after Delete: want error, got nil")
+    }
+}
+
+func TestWebhookLifecycle(t *testing.T) {
+    client, _ := dotmactest.NewTestClient(t)
+    ctx := context.Background()
+
+    wh, err := client.Webhooks.Register(ctx, dotmac.RegisterWebhookRequest{
+        URL:    "https://example.com/hooks/dotmac",
+        Events: []string{"ticket.created", "invoice.paid"},
+    })
+    if err != nil {
+        t.Fatalf("Register: %v", err)
+    }
+    if wh.URL != "https://example.com/hooks/dotmac" {
+        t.Fatalf("URL = %q, want %q", wh.URL, "https://example.com/hooks/dotmac")
+    }
+
+    page, err := client.Webhooks.List(ctx, dotmac.ListOptions{})
+    if err != nil {
+        t.Fatalf("List: %v", err)
+    }
+    if page.Total != 1 {
+        t.Fatalf("List Total = %d, want 1", page.Total)
+    }
+
+    if err := client.Webhooks.Delete(ctx, wh.ID); err != nil {
+        t.Fatalf("Delete: %v", err)
+    }
+    page, err = client.Webhooks.List(ctx, dotmac.ListOptions{})
+    if err != nil {
+        t.Fatalf("List after Delete: %v", err)
+    }
+    if page.Total != 0 {
+        t.Fatalf("List Total after Delete = %d, want 0", page.Total)
+    }
+}
+
+func TestListAllWalksEveryPage(t *testing.T) {
+    client, fs := dotmactest.NewTestClient(t)
+    ctx := context.Background()
+
+    for i := 0; i < 5; i++ {
+        fs.SeedCustomer(dotmac.Customer{ID: fmt.Sprintf("cust_%d", i), DisplayName: fmt.Sprintf("Customer %d", i)})
+    }
+
+    var seen []string
+    for c, err := range client.Customers.ListAll(ctx, dotmac.ListOptions{PageSize: 2}) {
+        if err != nil {
+            t.Fatalf("ListAll: %v", err)
+        }
+        seen = append(seen, c.ID)
+    }
+    if len(seen) != 5 {
+        t.Fatalf("ListAll yielded %d customers, want 5: %v", len(seen), seen)
+    }
+}
@@ -0,0 +1,477 @@
+// Package dotmactest provides test doubles for the dotmac SDK: an
+// in-memory fake API server and a VCR-style fixture recorder/player, so
+// consumers can unit-test code that uses dotmac.Client without a live API.
+package dotmactest
+
+import (
+    "encoding/json"
+    "fmt"
+    "net/http"
+    "net/http/httptest"
+    "sort"
+    "strconv"
+    "strings"
+    "sync"
+    "time"
+
+    dotmac "github.com/michaelayoade/dotmac_framework/sdk/go"
+)
+
+// FakeServer is an in-memory, map-backed implementation of the
+// customer/ticket/invoice endpoints used by dotmac.Client.
+type FakeServer struct {
+    *httptest.Server
+
+    mu        sync.Mutex
+    customers map[string]dotmac.Customer
+    tickets   map[string]dotmac.Ticket
+    invoices  map[string]dotmac.Invoice
+    webhooks  map[string]dotmac.Webhook
+    seq       int
+}
+
+// NewFakeServer starts an in-memory fake DotMac API server. Callers should
+// defer Close() (or use dotmactest.NewTestClient, which registers this via
+// t.Cleanup automatically).
+func NewFakeServer() *FakeServer {
+    fs := &FakeServer{
+        customers: make(map[string]dotmac.Customer),
+        tickets:   make(map[string]dotmac.Ticket),
+        invoices:  make(map[string]dotmac.Invoice),
+        webhooks:  make(map[string]dotmac.Webhook),
+    }
+    fs.Server = httptest.NewServer(http.HandlerFunc(fs.route))
+    return fs
+}
+
+// SeedCustomer inserts a customer directly into the store, bypassing Create, so
+// tests can set up fixtures without an extra round trip.
+func (fs *FakeServer) SeedCustomer(c dotmac.Customer) {
+    fs.mu.Lock()
+    defer fs.mu.Unlock()
+    fs.customers[c.ID] = c
+}
+
+// SeedTicket inserts a ticket directly into the store.
+func (fs *FakeServer) SeedTicket(t dotmac.Ticket) {
+    fs.mu.Lock()
+    defer fs.mu.Unlock()
+    fs.tickets[t.ID] = t
+}
+
+// SeedInvoice inserts an invoice directly into the store.
+func (fs *FakeServer) SeedInvoice(inv dotmac.Invoice) {
+    fs.mu.Lock()
+    defer fs.mu.Unlock()
+    fs.invoices[inv.ID] = inv
+}
+
+// SeedWebhook inserts a webhook subscription directly into the store.
+func (fs *FakeServer) SeedWebhook(wh dotmac.Webhook) {
+    fs.mu.Lock()
+    defer fs.mu.Unlock()
+    fs.webhooks[wh.ID] = wh
+}
+
+func (fs *FakeServer) nextID(prefix string) string {
+    fs.seq++
+    return fmt.Sprintf("%s_%d", prefix, fs.seq)
+}
+
+func (fs *FakeServer) route(w http.ResponseWriter, r *http.Request) {
+    fs.mu.Lock()
+    defer fs.mu.Unlock()
+
+    switch {
+    case r.Method == http.MethodPost && r.URL.Path == "/api/v1/customers":
+        fs.createCustomer(w, r)
+    case r.Method == http.MethodGet && r.URL.Path == "/api/v1/customers":
+        fs.listCustomers(w, r)
+    case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/api/v1/customers/"):
+        fs.getCustomer(w, idFromPath(r.URL.Path, "/api/v1/customers/"))
+
+    case r.Method == http.MethodPost && r.URL.Path == "/api/v1/tickets":
+        fs.createTicket(w, r)
+    case r.Method == http.MethodGet && r.URL.Path == "/api/v1/tickets":
+        fs.listTickets(w, r)
+    case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/api/v1/tickets/"):
+        fs.getTicket(w, idFromPath(r.URL.Path, "/api/v1/tickets/"))
+    case r.Method == http.MethodPut && strings.HasPrefix(r.URL.Path, "/api/v1/tickets/"):
+        fs.updateTicket(w, r, idFromPath(r.URL.Path, "/api/v1/tickets/"))
+    case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/api/v1/tickets/"):
+        fs.deleteTicket(w, idFromPath(r.URL.Path, "/api/v1/tickets/"))
+    case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/comments"):
+        fs.addTicketComment(w, r, idFromPath(strings.TrimSuffix(r.URL.Path, "/comments"), "/api/v1/tickets/"))
+    case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/assign"):
+        fs.assignTicket(w, r, idFromPath(strings.TrimSuffix(r.URL.Path, "/assign"), "/api/v1/tickets/"))
+    case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/status"):
+        fs.changeTicketStatus(w, r, idFromPath(strings.TrimSuffix(r.URL.Path, "/status"), "/api/v1/tickets/"))
+
+    case r.Method == http.MethodGet && r.URL.Path == "/api/v1/invoices":
+        fs.listInvoices(w, r)
+    case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/mark-paid"):
+        fs.markInvoicePaid(w, idFromPath(strings.TrimSuffix(r.URL.Path, "/mark-paid"), "/api/v1/invoices/"))
+    case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/void"):
+        fs.voidInvoice(w, idFromPath(strings.TrimSuffix(r.URL.Path, "/void"), "/api/v1/invoices/"))
+    case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/pdf"):
+        fs.downloadInvoicePDF(w, idFromPath(strings.TrimSuffix(r.URL.Path, "/pdf"), "/api/v1/invoices/"))
+    case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/api/v1/invoices/"):
+        fs.getInvoice(w, idFromPath(r.URL.Path, "/api/v1/invoices/"))
+    case r.Method == http.MethodPut && strings.HasPrefix(r.URL.Path, "/api/v1/invoices/"):
+        fs.updateInvoice(w, r, idFromPath(r.URL.Path, "/api/v1/invoices/"))
+    case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/api/v1/invoices/"):
+        fs.deleteInvoice(w, idFromPath(r.URL.Path, "/api/v1/invoices/"))
+
+    case r.Method == http.MethodPost && r.URL.Path == "/api/v1/webhooks":
+        fs.registerWebhook(w, r)
+    case r.Method == http.MethodGet && r.URL.Path == "/api/v1/webhooks":
+        fs.listWebhooks(w, r)
+    case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/api/v1/webhooks/"):
+        fs.deleteWebhook(w, idFromPath(r.URL.Path, "/api/v1/webhooks/"))
+
+    default:
+        writeError(w, http.StatusNotFound, "not_found", fmt.Sprintf("no fake route for %s %s", r.Method, r.URL.Path))
+    }
+}
+
+func idFromPath(path, prefix string) string {
+    return strings.TrimSuffix(strings.TrimPrefix(path, prefix), "/")
+}
+
+func (fs *FakeServer) createCustomer(w http.ResponseWriter, r *http.Request) {
+    var req dotmac.CreateCustomerRequest
+    if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+        writeError(w, http.StatusBadRequest, "invalid_body", err.Error())
+        return
+    }
+    if req.DisplayName == "" {
+        writeValidationError(w, "display_name", "display_name is required")
+        return
+    }
+    now := time.Now()
+    c := dotmac.Customer{
+        ID:           fs.nextID("cust"),
+        DisplayName:  req.DisplayName,
+        CustomerType: req.CustomerType,
+        State:        "active",
+        CreatedAt:    now,
+        UpdatedAt:    now,
+    }
+    fs.customers[c.ID] = c
+    writeJSON(w, http.StatusCreated, c)
+}
+
+func (fs *FakeServer) getCustomer(w http.ResponseWriter, id string) {
+    c, ok := fs.customers[id]
+    if !ok {
+        writeError(w, http.StatusNotFound, "not_found", "customer not found")
+        return
+    }
+    writeJSON(w, http.StatusOK, c)
+}
+
+func (fs *FakeServer) listCustomers(w http.ResponseWriter, r *http.Request) {
+    items := make([]dotmac.Customer, 0, len(fs.customers))
+    for _, c := range fs.customers {
+        items = append(items, c)
+    }
+    sort.Slice(items, func(i, j int) bool { return items[i].ID < items[j].ID })
+    writePage(w, r, items)
+}
+
+func (fs *FakeServer) createTicket(w http.ResponseWriter, r *http.Request) {
+    var req dotmac.CreateTicketRequest
+    if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+        writeError(w, http.StatusBadRequest, "invalid_body", err.Error())
+        return
+    }
+    now := time.Now()
+    t := dotmac.Ticket{
+        ID:         fs.nextID("ticket"),
+        CustomerID: req.CustomerID,
+        Subject:    req.Subject,
+        Status:     "open",
+        Priority:   req.Priority,
+        CreatedAt:  now,
+        UpdatedAt:  now,
+    }
+    fs.tickets[t.ID] = t
+    writeJSON(w, http.StatusCreated, t)
+}
+
+func (fs *FakeServer) getTicket(w http.ResponseWriter, id string) {
+    t, ok := fs.tickets[id]
+    if !ok {
+        writeError(w, http.StatusNotFound, "not_found", "ticket not found")
+        return
+    }
+    writeJSON(w, http.StatusOK, t)
+}
+
+func (fs *FakeServer) listTickets(w http.ResponseWriter, r *http.Request) {
+    items := make([]dotmac.Ticket, 0, len(fs.tickets))
+    for _, t := range fs.tickets {
+        items = append(items, t)
+    }
+    sort.Slice(items, func(i, j int) bool { return items[i].ID < items[j].ID })
+    writePage(w, r, items)
+}
+
+func (fs *FakeServer) updateTicket(w http.ResponseWriter, r *http.Request, id string) {
+    t, ok := fs.tickets[id]
+    if !ok {
+        writeError(w, http.StatusNotFound, "not_found", "ticket not found")
+        return
+    }
+    var req dotmac.UpdateTicketRequest
+    if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+        writeError(w, http.StatusBadRequest, "invalid_body", err.Error())
+        return
+    }
+    if req.Subject != "" {
+        t.Subject = req.Subject
+    }
+    if req.Priority != "" {
+        t.Priority = req.Priority
+    }
+    t.UpdatedAt = time.Now()
+    fs.tickets[id] = t
+    writeJSON(w, http.StatusOK, t)
+}
+
+func (fs *FakeServer) deleteTicket(w http.ResponseWriter, id string) {
+    if _, ok := fs.tickets[id]; !ok {
+        writeError(w, http.StatusNotFound, "not_found", "ticket not found")
+        return
+    }
+    delete(fs.tickets, id)
+    w.WriteHeader(http.StatusNoContent)
+}
+
+func (fs *FakeServer) addTicketComment(w http.ResponseWriter, r *http.Request, id string) {
+    if _, ok := fs.tickets[id]; !ok {
+        writeError(w, http.StatusNotFound, "not_found", "ticket not found")
+        return
+    }
+    var req dotmac.AddCommentRequest
+    if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+        writeError(w, http.StatusBadRequest, "invalid_body", err.Error())
+        return
+    }
+    comment := dotmac.Comment{
+        ID:        fs.nextID("comment"),
+        TicketID:  id,
+        Body:      req.Body,
+        CreatedAt: time.Now(),
+    }
+    writeJSON(w, http.StatusCreated, comment)
+}
+
+func (fs *FakeServer) assignTicket(w http.ResponseWriter, r *http.Request, id string) {
+    t, ok := fs.tickets[id]
+    if !ok {
+        writeError(w, http.StatusNotFound, "not_found", "ticket not found")
+        return
+    }
+    t.UpdatedAt = time.Now()
+    fs.tickets[id] = t
+    writeJSON(w, http.StatusOK, t)
+}
+
+func (fs *FakeServer) changeTicketStatus(w http.ResponseWriter, r *http.Request, id string) {
+    t, ok := fs.tickets[id]
+    if !ok {
+        writeError(w, http.StatusNotFound, "not_found", "ticket not found")
+        return
+    }
+    var body struct {
+        Status string `json:"status"`
+    }
+    if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+        writeError(w, http.StatusBadRequest, "invalid_body", err.Error())
+        return
+    }
+    t.Status = body.Status
+    t.UpdatedAt = time.Now()
+    fs.tickets[id] = t
+    writeJSON(w, http.StatusOK, t)
+}
+
+func (fs *FakeServer) getInvoice(w http.ResponseWriter, id string) {
+    inv, ok := fs.invoices[id]
+    if !ok {
+        writeError(w, http.StatusNotFound, "not_found", "invoice not found")
+        return
+    }
+    writeJSON(w, http.StatusOK, inv)
+}
+
+func (fs *FakeServer) listInvoices(w http.ResponseWriter, r *http.Request) {
+    customerID := r.URL.Query().Get("customer_id")
+    items := make([]dotmac.Invoice, 0, len(fs.invoices))
+    for _, inv := range fs.invoices {
+        if customerID != "" && inv.CustomerID != customerID {
+            continue
+        }
+        items = append(items, inv)
+    }
+    sort.Slice(items, func(i, j int) bool { return items[i].ID < items[j].ID })
+    writePage(w, r, items)
+}
+
+func (fs *FakeServer) markInvoicePaid(w http.ResponseWriter, id string) {
+    inv, ok := fs.invoices[id]
+    if !ok {
+        writeError(w, http.StatusNotFound, "not_found", "invoice not found")
+        return
+    }
+    inv.Status = "paid"
+    fs.invoices[id] = inv
+    writeJSON(w, http.StatusOK, inv)
+}
+
+func (fs *FakeServer) voidInvoice(w http.ResponseWriter, id string) {
+    inv, ok := fs.invoices[id]
+    if !ok {
+        writeError(w, http.StatusNotFound, "not_found", "invoice not found")
+        return
+    }
+    inv.Status = "void"
+    fs.invoices[id] = inv
+    writeJSON(w, http.StatusOK, inv)
+}
+
+func (fs *FakeServer) updateInvoice(w http.ResponseWriter, r *http.Request, id string) {
+    inv, ok := fs.invoices[id]
+    if !ok {
+        writeError(w, http.StatusNotFound, "not_found", "invoice not found")
+        return
+    }
+    var req dotmac.UpdateInvoiceRequest
+    if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+        writeError(w, http.StatusBadRequest, "invalid_body", err.Error())
+        return
+    }
+    if req.DueDate != "" {
+        inv.DueDate = req.DueDate
+    }
+    fs.invoices[id] = inv
+    writeJSON(w, http.StatusOK, inv)
+}
+
+func (fs *FakeServer) deleteInvoice(w http.ResponseWriter, id string) {
+    if _, ok := fs.invoices[id]; !ok {
+        writeError(w, http.StatusNotFound, "not_found", "invoice not found")
+        return
+    }
+    delete(fs.invoices, id)
+    w.WriteHeader(http.StatusNoContent)
+}
+
+func (fs *FakeServer) downloadInvoicePDF(w http.ResponseWriter, id string) {
+    if _, ok := fs.invoices[id]; !ok {
+        writeError(w, http.StatusNotFound, "not_found", "invoice not found")
+        return
+    }
+    w.Header().Set("Content-Type", "application/pdf")
+    w.WriteHeader(http.StatusOK)
+    _, _ = w.Write([]byte("%PDF-1.4 fake invoice " + id))
+}
+
+func (fs *FakeServer) registerWebhook(w http.ResponseWriter, r *http.Request) {
+    var req dotmac.RegisterWebhookRequest
+    if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+        writeError(w, http.StatusBadRequest, "invalid_body", err.Error())
+        return
+    }
+    wh := dotmac.Webhook{
+        ID:        fs.nextID("webhook"),
+        URL:       req.URL,
+        Events:    req.Events,
+        CreatedAt: time.Now(),
+    }
+    fs.webhooks[wh.ID] = wh
+    writeJSON(w, http.StatusCreated, wh)
+}
+
+func (fs *FakeServer) listWebhooks(w http.ResponseWriter, r *http.Request) {
+    items := make([]dotmac.Webhook, 0, len(fs.webhooks))
+    for _, wh := range fs.webhooks {
+        items = append(items, wh)
+    }
+    sort.Slice(items, func(i, j int) bool { return items[i].ID < items[j].ID })
+    writePage(w, r, items)
+}
+
+func (fs *FakeServer) deleteWebhook(w http.ResponseWriter, id string) {
+    if _, ok := fs.webhooks[id]; !ok {
+        writeError(w, http.StatusNotFound, "not_found", "webhook not found")
+        return
+    }
+    delete(fs.webhooks, id)
+    w.WriteHeader(http.StatusNoContent)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+    w.Header().Set("Content-Type", "application/json")
+    w.WriteHeader(status)
+    _ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+    writeJSON(w, status, dotmac.APIError{Code: code, Message: message})
+}
+
+// writeValidationError writes a 422 response with a single field-level
+// validation error and a request ID header, exercising the same shape the
+// real API uses for request validation failures.
+func writeValidationError(w http.ResponseWriter, field, message string) {
+    w.Header().Set("X-Request-ID", "req_validation_1")
+    writeJSON(w, http.StatusUnprocessableEntity, dotmac.APIError{
+        Code:    "validation_failed",
+        Message: "request failed validation",
+        Errors:  []dotmac.FieldError{{Field: field, Message: message}},
+    })
+}
+
+// writePage slices items per the "page"/"limit" query parameters (default
+// page 1, limit equal to len(items), i.e. everything on one page) and wraps
+// the slice in a dotmac.PaginatedResponse envelope, so ListAll callers
+// exercise real multi-page iteration against the fake server.
+func writePage[T any](w http.ResponseWriter, r *http.Request, items []T) {
+    page := 1
+    if p, err := strconv.Atoi(r.URL.Query().Get("page")); err == nil && p > 0 {
+        page = p
+    }
+    limit := len(items)
+    if l, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil && l > 0 {
+        limit = l
+    }
+
+    pages := 1
+    if limit > 0 {
+        pages = (len(items) + limit - 1) / limit
+        if pages == 0 {
+            pages = 1
+        }
+    }
+
+    start := (page - 1) * limit
+    end := start + limit
+    if start > len(items) {
+        start = len(items)
+    }
+    if end > len(items) {
+        end = len(items)
+    }
+
+    writeJSON(w, http.StatusOK, dotmac.PaginatedResponse[T]{
+        Items:   items[start:end],
+        Total:   len(items),
+        Page:    page,
+        Limit:   limit,
+        Pages:   pages,
+        HasNext: page < pages,
+        HasPrev: page > 1,
+    })
+}
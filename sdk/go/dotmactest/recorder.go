@@ -0,0 +1,148 @@
+package dotmactest
+
+import (
+    "bytes"
+    "encoding/json"
+    "fmt"
+    "io"
+    "net/http"
+    "os"
+    "sync"
+)
+
+// cassetteInteraction is one recorded HTTP round trip.
+type cassetteInteraction struct {
+    Method      string `json:"method"`
+    Path        string `json:"path"`
+    Query       string `json:"query,omitempty"`
+    RequestBody string `json:"request_body,omitempty"`
+    StatusCode  int    `json:"status_code"`
+    Body        string `json:"body"`
+}
+
+// cassette is the on-disk fixture format written/read by recordingTransport.
+type cassette struct {
+    Interactions []cassetteInteraction `json:"interactions"`
+}
+
+// recordingTransport is an http.RoundTripper that records live interactions
+// to a cassette file on first run and replays them, in order, on every
+// subsequent run.
+type recordingTransport struct {
+    path      string
+    upstream  http.RoundTripper
+    recording bool
+
+    mu        sync.Mutex
+    cassette  cassette
+    playIndex int
+}
+
+// newRecordingTransport loads path if it exists (replay mode) or starts an
+// empty cassette to be populated against upstream (record mode).
+func newRecordingTransport(path string, upstream http.RoundTripper) (*recordingTransport, error) {
+    if upstream == nil {
+        upstream = http.DefaultTransport
+    }
+    rt := &recordingTransport{path: path, upstream: upstream}
+
+    data, err := os.ReadFile(path)
+    switch {
+    case err == nil:
+        if err := json.Unmarshal(data, &rt.cassette); err != nil {
+            return nil, fmt.Errorf("dotmactest: parse cassette %s: %w", path, err)
+        }
+    case os.IsNotExist(err):
+        rt.recording = true
+    default:
+        return nil, fmt.Errorf("dotmactest: read cassette %s: %w", path, err)
+    }
+    return rt, nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (rt *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+    if rt.recording {
+        return rt.record(req)
+    }
+    return rt.replay(req)
+}
+
+func (rt *recordingTransport) record(req *http.Request) (*http.Response, error) {
+    var reqBody string
+    if req.Body != nil {
+        b, err := io.ReadAll(req.Body)
+        if err != nil {
+            return nil, err
+        }
+        req.Body.Close()
+        req.Body = io.NopCloser(bytes.NewReader(b))
+        reqBody = string(b)
+    }
+
+    resp, err := rt.upstream.RoundTrip(req)
+    if err != nil {
+        return nil, err
+    }
+
+    respBody, err := io.ReadAll(resp.Body)
+    if err != nil {
+        return nil, err
+    }
+    resp.Body.Close()
+    resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+    rt.mu.Lock()
+    rt.cassette.Interactions = append(rt.cassette.Interactions, cassetteInteraction{
+        Method:      req.Method,
+        Path:        req.URL.Path,
+        Query:       req.URL.RawQuery,
+        RequestBody: reqBody,
+        StatusCode:  resp.StatusCode,
+        Body:        string(respBody),
+    })
+    rt.mu.Unlock()
+
+    return resp, nil
+}
+
+func (rt *recordingTransport) replay(req *http.Request) (*http.Response, error) {
+    rt.mu.Lock()
+    defer rt.mu.Unlock()
+
+    if rt.playIndex >= len(rt.cassette.Interactions) {
+        return nil, fmt.Errorf("dotmactest: cassette %s has no recorded interaction for %s %s", rt.path, req.Method, req.URL.Path)
+    }
+    interaction := rt.cassette.Interactions[rt.playIndex]
+    if interaction.Method != req.Method || interaction.Path != req.URL.Path {
+        return nil, fmt.Errorf("dotmactest: cassette %s mismatch at interaction %d: recorded %s %s, got %s %s",
+            rt.path, rt.playIndex, interaction.Method, interaction.Path, req.Method, req.URL.Path)
+    }
+    rt.playIndex++
+
+    header := http.Header{}
+    header.Set("Content-Type", "application/json")
+    return &http.Response{
+        StatusCode: interaction.StatusCode,
+        Header:     header,
+        Body:       io.NopCloser(bytes.NewReader([]byte(interaction.Body))),
+        Request:    req,
+    }, nil
+}
+
+// save writes the recorded cassette to disk. It is a no-op in replay mode.
+func (rt *recordingTransport) save() error {
+    if !rt.recording {
+        return nil
+    }
+    rt.mu.Lock()
+    data, err := json.MarshalIndent(rt.cassette, "", "  ")
+    rt.mu.Unlock()
+    if err != nil {
+        return fmt.Errorf("dotmactest: marshal cassette: %w", err)
+    }
+    if err := os.WriteFile(rt.path, data, 0o644); err != nil {
+        return fmt.Errorf("dotmactest: write cassette %s: %w", rt.path, err)
+    }
+    return nil
+}
@@ -0,0 +1,73 @@
+package dotmactest
+
+import (
+    "context"
+    "encoding/json"
+    "net/http"
+    "net/http/httptest"
+    "os"
+    "path/filepath"
+    "strings"
+    "testing"
+
+    dotmac "github.com/michaelayoade/dotmac_framework/sdk/go"
+)
+
+func TestRecordingTransportRecordsThenReplays(t *testing.T) {
+    upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        w.Header().Set("Content-Type", "application/json")
+        _ = json.NewEncoder(w).Encode(dotmac.Customer{ID: strings.TrimPrefix(r.URL.Path, "/api/v1/customers/")})
+    }))
+    defer upstream.Close()
+
+    cassette := filepath.Join(t.TempDir(), "customer.json")
+
+    recording := dotmac.NewClient(dotmac.Config{BaseURL: upstream.URL})
+    transport, err := newRecordingTransport(cassette, nil)
+    if err != nil {
+        t.Fatalf("newRecordingTransport: %v", err)
+    }
+    recording.SetTransport(transport)
+
+    if _, err := recording.Customers.Get(context.Background(), "cust_1"); err != nil {
+        t.Fatalf("Get cust_1 against upstream: %v", err)
+    }
+    if _, err := recording.Customers.Get(context.Background(), "cust_2"); err != nil {
+        t.Fatalf("Get cust_2 against upstream: %v", err)
+    }
+    if err := transport.save(); err != nil {
+        t.Fatalf("save: %v", err)
+    }
+    if _, err := os.Stat(cassette); err != nil {
+        t.Fatalf("cassette was not written: %v", err)
+    }
+
+    // A second client, pointed at an upstream that always errors, should be
+    // served entirely from the cassette with no live calls.
+    deadUpstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        t.Fatal("replay mode should never hit the live upstream")
+    }))
+    defer deadUpstream.Close()
+
+    replaying := dotmac.NewClient(dotmac.Config{BaseURL: deadUpstream.URL})
+    replayTransport, err := newRecordingTransport(cassette, nil)
+    if err != nil {
+        t.Fatalf("newRecordingTransport (replay): %v", err)
+    }
+    replaying.SetTransport(replayTransport)
+
+    replayed, err := replaying.Customers.Get(context.Background(), "cust_1")
+    if err != nil {
+        t.Fatalf("Get from cassette: %v", err)
+    }
+    if replayed.ID != "cust_1" {
+        t.Fatalf("ID = %q, want %q", replayed.ID, "cust_1")
+    }
+
+    // The next recorded interaction is Get cust_2; requesting cust_1 again
+    // out of order must fail loudly instead of silently serving cust_2's
+    // fixture back for the wrong request.
+    if _, err := replaying.Customers.Get(context.Background(), "cust_1"); err == nil {
+        t.Fatal("out-of-order replay: want cassette-mismatch error, got nil")
+    }
+}
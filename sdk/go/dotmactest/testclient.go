@@ -0,0 +1,44 @@
+package dotmactest
+
+import (
+    "testing"
+
+    dotmac "github.com/michaelayoade/dotmac_framework/sdk/go"
+)
+
+// NewTestClient starts an in-memory FakeServer and returns a dotmac.Client
+// wired to it, along with the FakeServer itself for seeding fixtures or
+// inspecting state. The server is closed automatically via t.Cleanup.
+func NewTestClient(t *testing.T) (*dotmac.Client, *FakeServer) {
+    t.Helper()
+
+    fs := NewFakeServer()
+    t.Cleanup(fs.Close)
+
+    client := dotmac.NewClient(dotmac.Config{BaseURL: fs.URL})
+    return client, fs
+}
+
+// RecordingClient returns a dotmac.Client configured per config, with its
+// transport replaced by a VCR-style recorder bound to cassettePath: if the
+// file exists, every request is served from it in order; if it doesn't,
+// requests go to the live API (per config.BaseURL) and are captured to the
+// file on test cleanup. Commit the resulting cassette so later test runs
+// replay deterministically without a live API.
+func RecordingClient(t *testing.T, config dotmac.Config, cassettePath string) *dotmac.Client {
+    t.Helper()
+
+    transport, err := newRecordingTransport(cassettePath, nil)
+    if err != nil {
+        t.Fatalf("dotmactest: %v", err)
+    }
+    t.Cleanup(func() {
+        if err := transport.save(); err != nil {
+            t.Errorf("dotmactest: %v", err)
+        }
+    })
+
+    client := dotmac.NewClient(config)
+    client.SetTransport(transport)
+    return client
+}
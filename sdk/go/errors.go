@@ -0,0 +1,28 @@
+package dotmac
+
+import "fmt"
+
+// FieldError describes a single field-level validation failure returned by
+// the API, e.g. {"field": "primary_email", "message": "must be a valid email"}.
+type FieldError struct {
+    Field   string `json:"field"`
+    Message string `json:"message"`
+}
+
+// APIError represents a non-2xx response from the DotMac API. It is returned
+// by every service method and can be matched with errors.As.
+type APIError struct {
+    StatusCode int          `json:"-"`
+    Code       string       `json:"code"`
+    Message    string       `json:"message"`
+    RequestID  string       `json:"request_id"`
+    Errors     []FieldError `json:"errors,omitempty"`
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+    if len(e.Errors) > 0 {
+        return fmt.Sprintf("dotmac: %d %s: %s (%d field error(s))", e.StatusCode, e.Code, e.Message, len(e.Errors))
+    }
+    return fmt.Sprintf("dotmac: %d %s: %s", e.StatusCode, e.Code, e.Message)
+}
@@ -0,0 +1,103 @@
+package dotmac
+
+import (
+    "context"
+    "encoding/json"
+    "fmt"
+    "io"
+    "iter"
+    "net/http"
+    "time"
+
+    "github.com/go-resty/resty/v2"
+)
+
+// Invoice represents an invoice
+type Invoice struct {
+    ID         string    `json:"id"`
+    CustomerID string    `json:"customer_id"`
+    Amount     float64   `json:"amount"`
+    Currency   string    `json:"currency"`
+    Status     string    `json:"status"`
+    DueDate    string    `json:"due_date"`
+    CreatedAt  time.Time `json:"created_at"`
+}
+
+// UpdateInvoiceRequest represents a partial update to an invoice.
+type UpdateInvoiceRequest struct {
+    DueDate string `json:"due_date,omitempty"`
+}
+
+// InvoiceService handles invoice-related operations
+type InvoiceService struct {
+    client *Client
+}
+
+// Get retrieves an invoice by ID.
+func (s *InvoiceService) Get(ctx context.Context, invoiceID string) (*Invoice, error) {
+    return doGETRequest[Invoice](ctx, s.client, "invoices.get", fmt.Sprintf("/api/v1/invoices/%s", invoiceID))
+}
+
+// List retrieves a single page of invoices, optionally scoped to a customer
+func (s *InvoiceService) List(ctx context.Context, customerID string, opts ListOptions) (*PaginatedResponse[Invoice], error) {
+    return doGETListRequest[Invoice](ctx, s.client, "invoices.list", "/api/v1/invoices", opts, func(r *resty.Request) {
+        if customerID != "" {
+            r.SetQueryParam("customer_id", customerID)
+        }
+    })
+}
+
+// ListAll returns an iterator over every invoice matching opts and
+// customerID, fetching additional pages from the API as needed.
+func (s *InvoiceService) ListAll(ctx context.Context, customerID string, opts ListOptions) iter.Seq2[Invoice, error] {
+    return listAll(ctx, opts, func(ctx context.Context, o ListOptions) (*PaginatedResponse[Invoice], error) {
+        return s.List(ctx, customerID, o)
+    })
+}
+
+// Update applies a partial update to an invoice.
+func (s *InvoiceService) Update(ctx context.Context, invoiceID string, req UpdateInvoiceRequest) (*Invoice, error) {
+    return doPUTRequest[Invoice](ctx, s.client, "invoices.update", fmt.Sprintf("/api/v1/invoices/%s", invoiceID), req)
+}
+
+// Delete permanently removes an invoice.
+func (s *InvoiceService) Delete(ctx context.Context, invoiceID string) error {
+    return doDELETERequest(ctx, s.client, "invoices.delete", fmt.Sprintf("/api/v1/invoices/%s", invoiceID))
+}
+
+// MarkPaid marks an invoice as paid.
+func (s *InvoiceService) MarkPaid(ctx context.Context, invoiceID string) (*Invoice, error) {
+    return doPOSTRequest[Invoice](ctx, s.client, "invoices.mark_paid", fmt.Sprintf("/api/v1/invoices/%s/mark-paid", invoiceID), nil)
+}
+
+// Void voids an invoice, preventing any further collection attempts.
+func (s *InvoiceService) Void(ctx context.Context, invoiceID string) (*Invoice, error) {
+    return doPOSTRequest[Invoice](ctx, s.client, "invoices.void", fmt.Sprintf("/api/v1/invoices/%s/void", invoiceID), nil)
+}
+
+// DownloadPDF streams the rendered PDF for an invoice. The caller is
+// responsible for closing the returned ReadCloser.
+func (s *InvoiceService) DownloadPDF(ctx context.Context, invoiceID string) (io.ReadCloser, error) {
+    path := fmt.Sprintf("/api/v1/invoices/%s/pdf", invoiceID)
+    ctx, end := s.client.startObservedRequest(ctx, "invoices.download_pdf", http.MethodGet, path, nil)
+
+    resp, err := s.client.http.R().
+        SetContext(ctx).
+        SetDoNotParseResponse(true).
+        Get(path)
+    if err != nil {
+        end(nil, err)
+        return nil, err
+    }
+    hr := &httpResult{statusCode: resp.StatusCode()}
+    if resp.IsError() {
+        defer resp.RawBody().Close()
+        apiErr := &APIError{StatusCode: resp.StatusCode(), RequestID: resp.Header().Get("X-Request-ID")}
+        body, _ := io.ReadAll(resp.RawBody())
+        _ = json.Unmarshal(body, apiErr)
+        end(hr, apiErr)
+        return nil, apiErr
+    }
+    end(hr, nil)
+    return resp.RawBody(), nil
+}
@@ -0,0 +1,11 @@
+package dotmac
+
+// ListOptions controls pagination and filtering for List/ListAll calls.
+// Filter keys and value types are endpoint-specific (e.g. "state",
+// "customer_type", "created_after") and are serialized as a single
+// JSON-encoded "filter" query parameter.
+type ListOptions struct {
+    Page     int
+    PageSize int
+    Filter   map[string]any
+}
@@ -0,0 +1,221 @@
+package dotmac_test
+
+import (
+    "bytes"
+    "context"
+    "encoding/json"
+    "errors"
+    "log/slog"
+    "net/http"
+    "net/http/httptest"
+    "strings"
+    "sync/atomic"
+    "testing"
+    "time"
+
+    "github.com/go-resty/resty/v2"
+    dotmac "github.com/michaelayoade/dotmac_framework/sdk/go"
+    "go.opentelemetry.io/otel/metric/noop"
+    tracenoop "go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestRetrySucceedsAfterTransientFailures(t *testing.T) {
+    var attempts int32
+    server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        if atomic.AddInt32(&attempts, 1) <= 2 {
+            w.WriteHeader(http.StatusServiceUnavailable)
+            return
+        }
+        w.Header().Set("Content-Type", "application/json")
+        _ = json.NewEncoder(w).Encode(dotmac.Customer{ID: "cust_1"})
+    }))
+    defer server.Close()
+
+    client := dotmac.NewClient(dotmac.Config{
+        BaseURL:      server.URL,
+        MaxRetries:   2,
+        RetryBackoff: dotmac.RetryBackoff{Base: time.Millisecond, Max: 5 * time.Millisecond},
+    })
+
+    got, err := client.Customers.Get(context.Background(), "cust_1")
+    if err != nil {
+        t.Fatalf("Get: %v", err)
+    }
+    if got.ID != "cust_1" {
+        t.Fatalf("ID = %q, want %q", got.ID, "cust_1")
+    }
+    if atomic.LoadInt32(&attempts) != 3 {
+        t.Fatalf("attempts = %d, want 3", attempts)
+    }
+}
+
+func TestRateLimiterBlocksUntilContextDeadline(t *testing.T) {
+    server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        w.Header().Set("Content-Type", "application/json")
+        _ = json.NewEncoder(w).Encode(dotmac.Customer{ID: "cust_1"})
+    }))
+    defer server.Close()
+
+    client := dotmac.NewClient(dotmac.Config{
+        BaseURL:   server.URL,
+        RateLimit: dotmac.RateLimit{RequestsPerSecond: 0.001, Burst: 1},
+    })
+
+    ctx := context.Background()
+    if _, err := client.Customers.Get(ctx, "cust_1"); err != nil {
+        t.Fatalf("first Get (consumes the only token): %v", err)
+    }
+
+    ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+    defer cancel()
+    if _, err := client.Customers.Get(ctx, "cust_1"); err == nil {
+        t.Fatal("second Get: want context deadline error, got nil")
+    }
+}
+
+func TestCircuitBreakerOpensAndHeals(t *testing.T) {
+    var calls int32
+    server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        atomic.AddInt32(&calls, 1)
+        w.WriteHeader(http.StatusInternalServerError)
+    }))
+    defer server.Close()
+
+    client := dotmac.NewClient(dotmac.Config{
+        BaseURL: server.URL,
+        Breaker: dotmac.BreakerPolicy{FailureThreshold: 2, OpenDuration: 30 * time.Millisecond},
+    })
+    ctx := context.Background()
+
+    for i := 0; i < 2; i++ {
+        if _, err := client.Customers.Get(ctx, "cust_1"); err == nil {
+            t.Fatal("Get: want error from 500 response, got nil")
+        }
+    }
+
+    if _, err := client.Customers.Get(ctx, "cust_1"); !errors.Is(err, dotmac.ErrCircuitOpen) {
+        t.Fatalf("Get with breaker tripped: err = %v, want ErrCircuitOpen", err)
+    }
+    if atomic.LoadInt32(&calls) != 2 {
+        t.Fatalf("calls reaching the server while breaker is open = %d, want 2", calls)
+    }
+
+    time.Sleep(40 * time.Millisecond)
+
+    if _, err := client.Customers.Get(ctx, "cust_1"); err == nil {
+        t.Fatal("half-open probe: want error from 500 response, got nil")
+    }
+    if atomic.LoadInt32(&calls) != 3 {
+        t.Fatalf("calls after OpenDuration elapses = %d, want 3 (one half-open probe)", calls)
+    }
+}
+
+func TestOAuth2TokenRefreshAttachesBearerToken(t *testing.T) {
+    tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        w.Header().Set("Content-Type", "application/json")
+        _ = json.NewEncoder(w).Encode(map[string]any{
+            "access_token":  "access-123",
+            "refresh_token": "refresh-456",
+            "expires_in":    3600,
+        })
+    }))
+    defer tokenServer.Close()
+
+    var gotAuth string
+    apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        gotAuth = r.Header.Get("Authorization")
+        w.Header().Set("Content-Type", "application/json")
+        _ = json.NewEncoder(w).Encode(dotmac.Customer{ID: "cust_1"})
+    }))
+    defer apiServer.Close()
+
+    var rotatedRefresh string
+    client := dotmac.NewClient(dotmac.Config{
+        BaseURL: apiServer.URL,
+        OAuth2: dotmac.OAuth2Config{
+            ClientID:     "client",
+            ClientSecret: "secret",
+            TokenURL:     tokenServer.URL,
+            RefreshToken: "refresh-initial",
+        },
+        OnTokenRefresh: func(accessToken, refreshToken string) {
+            rotatedRefresh = refreshToken
+        },
+    })
+
+    if _, err := client.Customers.Get(context.Background(), "cust_1"); err != nil {
+        t.Fatalf("Get: %v", err)
+    }
+    if gotAuth != "Bearer access-123" {
+        t.Fatalf("Authorization header = %q, want %q", gotAuth, "Bearer access-123")
+    }
+    if rotatedRefresh != "refresh-456" {
+        t.Fatalf("OnTokenRefresh refresh token = %q, want %q", rotatedRefresh, "refresh-456")
+    }
+}
+
+func TestObservabilityLogsRedactedRequestBody(t *testing.T) {
+    server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        w.Header().Set("Content-Type", "application/json")
+        w.WriteHeader(http.StatusCreated)
+        _ = json.NewEncoder(w).Encode(dotmac.Customer{ID: "cust_1", DisplayName: "Ada Lovelace"})
+    }))
+    defer server.Close()
+
+    var logs bytes.Buffer
+    logger := slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+    client := dotmac.NewClient(dotmac.Config{
+        BaseURL:      server.URL,
+        Tracer:       tracenoop.NewTracerProvider(),
+        Meter:        noop.NewMeterProvider(),
+        Logger:       logger,
+        RedactFields: []string{"primary_email"},
+    })
+
+    _, err := client.Customers.Create(context.Background(), dotmac.CreateCustomerRequest{
+        DisplayName:  "Ada Lovelace",
+        PrimaryEmail: "ada@example.com",
+    })
+    if err != nil {
+        t.Fatalf("Create: %v", err)
+    }
+
+    output := logs.String()
+    if !strings.Contains(output, "dotmac: request") || !strings.Contains(output, "dotmac: response") {
+        t.Fatalf("expected request/response log lines, got:\n%s", output)
+    }
+    if strings.Contains(output, "ada@example.com") {
+        t.Fatalf("expected primary_email to be redacted, got:\n%s", output)
+    }
+    if !strings.Contains(output, "***") {
+        t.Fatalf("expected a redacted *** placeholder in logged body, got:\n%s", output)
+    }
+}
+
+func TestClientUseAcceptsPlainClosures(t *testing.T) {
+    server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        w.Header().Set("Content-Type", "application/json")
+        _ = json.NewEncoder(w).Encode(dotmac.Customer{ID: "cust_1"})
+    }))
+    defer server.Close()
+
+    client := dotmac.NewClient(dotmac.Config{BaseURL: server.URL})
+
+    var called bool
+    err := client.Use(func(c *resty.Client, req *resty.Request) error {
+        called = true
+        req.SetHeader("X-Custom", "1")
+        return nil
+    })
+    if err != nil {
+        t.Fatalf("Use: %v", err)
+    }
+
+    if _, err := client.Customers.Get(context.Background(), "cust_1"); err != nil {
+        t.Fatalf("Get: %v", err)
+    }
+    if !called {
+        t.Fatal("plain closure registered via Use was never invoked")
+    }
+}
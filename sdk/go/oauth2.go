@@ -0,0 +1,164 @@
+package dotmac
+
+import (
+    "context"
+    "encoding/json"
+    "fmt"
+    "net/http"
+    "net/url"
+    "strings"
+    "sync"
+    "time"
+)
+
+// OAuth2Config configures automatic access-token acquisition and refresh
+// for a Client using the OAuth2 refresh_token grant.
+type OAuth2Config struct {
+    ClientID     string
+    ClientSecret string
+    TokenURL     string
+    Scopes       []string
+    RefreshToken string
+
+    // RefreshWindow is how long before expiry the access token is
+    // proactively refreshed. Defaults to 60s.
+    RefreshWindow time.Duration
+}
+
+// TokenSource supplies the access token attached to every outgoing request.
+// The built-in refreshingTokenSource implements the refresh_token grant;
+// Client.SetAccessToken installs a static TokenSource for manual rotation.
+type TokenSource interface {
+    Token(ctx context.Context) (string, error)
+}
+
+// staticTokenSource is a TokenSource that always returns the same token,
+// used by Client.SetAccessToken.
+type staticTokenSource string
+
+func (s staticTokenSource) Token(context.Context) (string, error) {
+    return string(s), nil
+}
+
+// tokenResponse is the shape of a standard OAuth2 token endpoint response.
+type tokenResponse struct {
+    AccessToken  string `json:"access_token"`
+    RefreshToken string `json:"refresh_token"`
+    ExpiresIn    int    `json:"expires_in"`
+}
+
+// refreshingTokenSource implements TokenSource on top of OAuth2Config's
+// refresh_token grant. It caches the current access token, proactively
+// refreshes it RefreshWindow before expiry, and serializes concurrent
+// refreshes so only one refresh request is ever in flight.
+type refreshingTokenSource struct {
+    config    OAuth2Config
+    http      *http.Client
+    onRefresh func(accessToken, refreshToken string)
+
+    mu           sync.Mutex
+    accessToken  string
+    refreshToken string
+    expiresAt    time.Time
+    refreshing   chan struct{}
+}
+
+func newRefreshingTokenSource(config OAuth2Config) *refreshingTokenSource {
+    if config.RefreshWindow <= 0 {
+        config.RefreshWindow = 60 * time.Second
+    }
+    return &refreshingTokenSource{
+        config:       config,
+        http:         &http.Client{Timeout: 30 * time.Second},
+        refreshToken: config.RefreshToken,
+    }
+}
+
+// Token returns a currently valid access token, refreshing it first if it is
+// missing or within config.RefreshWindow of expiry. Concurrent callers that
+// arrive while a refresh is already in flight wait for it to finish rather
+// than issuing their own request.
+func (s *refreshingTokenSource) Token(ctx context.Context) (string, error) {
+    s.mu.Lock()
+    if s.accessToken != "" && time.Until(s.expiresAt) > s.config.RefreshWindow {
+        token := s.accessToken
+        s.mu.Unlock()
+        return token, nil
+    }
+    if s.refreshing != nil {
+        waitCh := s.refreshing
+        s.mu.Unlock()
+        select {
+        case <-waitCh:
+            return s.Token(ctx)
+        case <-ctx.Done():
+            return "", ctx.Err()
+        }
+    }
+    s.refreshing = make(chan struct{})
+    s.mu.Unlock()
+
+    token, err := s.doRefresh(ctx)
+
+    s.mu.Lock()
+    close(s.refreshing)
+    s.refreshing = nil
+    s.mu.Unlock()
+
+    return token, err
+}
+
+// doRefresh performs the refresh_token grant against config.TokenURL and
+// updates the cached token on success.
+func (s *refreshingTokenSource) doRefresh(ctx context.Context) (string, error) {
+    s.mu.Lock()
+    refreshToken := s.refreshToken
+    s.mu.Unlock()
+
+    form := url.Values{}
+    form.Set("grant_type", "refresh_token")
+    form.Set("refresh_token", refreshToken)
+    form.Set("client_id", s.config.ClientID)
+    form.Set("client_secret", s.config.ClientSecret)
+    if len(s.config.Scopes) > 0 {
+        form.Set("scope", strings.Join(s.config.Scopes, " "))
+    }
+
+    req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.config.TokenURL, strings.NewReader(form.Encode()))
+    if err != nil {
+        return "", fmt.Errorf("dotmac: build token refresh request: %w", err)
+    }
+    req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+    resp, err := s.http.Do(req)
+    if err != nil {
+        return "", fmt.Errorf("dotmac: token refresh: %w", err)
+    }
+    defer resp.Body.Close()
+
+    if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+        return "", fmt.Errorf("dotmac: token refresh returned status %d", resp.StatusCode)
+    }
+
+    var result tokenResponse
+    if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+        return "", fmt.Errorf("dotmac: decode token refresh response: %w", err)
+    }
+
+    s.mu.Lock()
+    s.accessToken = result.AccessToken
+    if result.RefreshToken != "" {
+        s.refreshToken = result.RefreshToken
+    }
+    if result.ExpiresIn > 0 {
+        s.expiresAt = time.Now().Add(time.Duration(result.ExpiresIn) * time.Second)
+    }
+    accessToken, rotatedRefreshToken := s.accessToken, s.refreshToken
+    s.mu.Unlock()
+
+    if s.onRefresh != nil {
+        s.onRefresh(accessToken, rotatedRefreshToken)
+    }
+
+    return accessToken, nil
+}
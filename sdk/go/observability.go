@@ -0,0 +1,179 @@
+package dotmac
+
+import (
+    "context"
+    "encoding/json"
+    "fmt"
+    "log/slog"
+    "time"
+
+    "go.opentelemetry.io/otel/attribute"
+    "go.opentelemetry.io/otel/codes"
+    "go.opentelemetry.io/otel/metric"
+    semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+    "go.opentelemetry.io/otel/trace"
+)
+
+// observability holds the tracer, metric instruments, and logger derived
+// from Config.Tracer/Config.Meter/Config.Logger. A nil *observability (the
+// common case when none of those are configured) disables the overhead
+// entirely.
+type observability struct {
+    tracer          trace.Tracer
+    requestDuration metric.Float64Histogram
+    requestCount    metric.Int64Counter
+    errorCount      metric.Int64Counter
+
+    logger *slog.Logger
+    redact map[string]bool
+}
+
+// newObservability builds an *observability from config, or returns nil if
+// none of Tracer, Meter, or Logger are set.
+func newObservability(config Config) *observability {
+    if config.Tracer == nil && config.Meter == nil && config.Logger == nil {
+        return nil
+    }
+
+    obs := &observability{logger: config.Logger}
+
+    if len(config.RedactFields) > 0 {
+        obs.redact = make(map[string]bool, len(config.RedactFields))
+        for _, field := range config.RedactFields {
+            obs.redact[field] = true
+        }
+    }
+
+    if config.Tracer != nil {
+        obs.tracer = config.Tracer.Tracer("dotmac")
+    }
+
+    if config.Meter != nil {
+        meter := config.Meter.Meter("dotmac")
+        obs.requestDuration, _ = meter.Float64Histogram("dotmac.request.duration", metric.WithUnit("s"),
+            metric.WithDescription("Duration of DotMac API requests"))
+        obs.requestCount, _ = meter.Int64Counter("dotmac.request.count",
+            metric.WithDescription("Number of DotMac API requests"))
+        obs.errorCount, _ = meter.Int64Counter("dotmac.request.errors",
+            metric.WithDescription("Number of DotMac API requests that returned an error"))
+    }
+
+    return obs
+}
+
+// startObservedRequest starts a span (if tracing is configured) named
+// "dotmac.{operation}" and logs the outgoing request, returning a context
+// carrying the span plus an end func that records the span's status and
+// duration/count/error metrics. Safe to call on a nil *observability.
+func (c *Client) startObservedRequest(ctx context.Context, operation, method, path string, body any) (context.Context, func(resp *httpResult, err error)) {
+    obs := c.obs
+    if obs == nil {
+        return ctx, func(*httpResult, error) {}
+    }
+
+    start := time.Now()
+    var span trace.Span
+    if obs.tracer != nil {
+        ctx, span = obs.tracer.Start(ctx, fmt.Sprintf("dotmac.%s", operation),
+            trace.WithSpanKind(trace.SpanKindClient),
+            trace.WithAttributes(
+                semconv.HTTPRequestMethodKey.String(method),
+                attribute.String("dotmac.operation", operation),
+            ))
+    }
+
+    if obs.logger != nil {
+        obs.logger.DebugContext(ctx, "dotmac: request", "operation", operation, "method", method, "path", path, "body", obs.redactBody(body))
+    }
+
+    return ctx, func(resp *httpResult, err error) {
+        duration := time.Since(start)
+
+        attrs := []attribute.KeyValue{
+            attribute.String("dotmac.operation", operation),
+            semconv.HTTPRequestMethodKey.String(method),
+        }
+        status := 0
+        if resp != nil {
+            status = resp.statusCode
+            attrs = append(attrs, semconv.HTTPResponseStatusCodeKey.Int(status))
+        }
+
+        if span != nil {
+            if status != 0 {
+                span.SetAttributes(semconv.HTTPResponseStatusCodeKey.Int(status))
+            }
+            if err != nil {
+                span.RecordError(err)
+                span.SetStatus(codes.Error, err.Error())
+            } else {
+                span.SetStatus(codes.Ok, "")
+            }
+            span.End()
+        }
+
+        if obs.requestDuration != nil {
+            obs.requestDuration.Record(ctx, duration.Seconds(), metric.WithAttributes(attrs...))
+        }
+        if obs.requestCount != nil {
+            obs.requestCount.Add(ctx, 1, metric.WithAttributes(attrs...))
+        }
+        if err != nil && obs.errorCount != nil {
+            obs.errorCount.Add(ctx, 1, metric.WithAttributes(attrs...))
+        }
+
+        if obs.logger != nil {
+            level := slog.LevelDebug
+            if err != nil {
+                level = slog.LevelWarn
+            }
+            obs.logger.Log(ctx, level, "dotmac: response",
+                "operation", operation, "method", method, "path", path,
+                "status", status, "duration", duration, "error", errString(err))
+        }
+    }
+}
+
+// httpResult carries the subset of a resty.Response that startObservedRequest
+// needs, keeping this file free of a direct resty import.
+type httpResult struct {
+    statusCode int
+}
+
+// redactBody returns body re-marshaled to JSON with any top-level keys in
+// obs.redact masked, for safe inclusion in debug logs. Non-map bodies and
+// marshal failures are returned unchanged/omitted.
+func (o *observability) redactBody(body any) json.RawMessage {
+    if o == nil || body == nil {
+        return nil
+    }
+    raw, err := json.Marshal(body)
+    if err != nil {
+        return nil
+    }
+    if len(o.redact) == 0 {
+        return raw
+    }
+
+    var fields map[string]json.RawMessage
+    if err := json.Unmarshal(raw, &fields); err != nil {
+        return raw
+    }
+    for field := range fields {
+        if o.redact[field] {
+            fields[field] = json.RawMessage(`"***"`)
+        }
+    }
+    redacted, err := json.Marshal(fields)
+    if err != nil {
+        return raw
+    }
+    return redacted
+}
+
+func errString(err error) string {
+    if err == nil {
+        return ""
+    }
+    return err.Error()
+}
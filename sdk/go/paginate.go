@@ -0,0 +1,42 @@
+package dotmac
+
+import (
+    "context"
+    "iter"
+)
+
+// listAll builds an iter.Seq2 that transparently walks every page of a
+// paginated listing, starting at opts.Page (default 1) and following
+// PaginatedResponse.HasNext until the last page. Iteration stops early if
+// list returns an error, surfacing it as the final yielded value.
+func listAll[T any](ctx context.Context, opts ListOptions, list func(context.Context, ListOptions) (*PaginatedResponse[T], error)) iter.Seq2[T, error] {
+    return func(yield func(T, error) bool) {
+        page := opts.Page
+        if page <= 0 {
+            page = 1
+        }
+
+        for {
+            pageOpts := opts
+            pageOpts.Page = page
+
+            resp, err := list(ctx, pageOpts)
+            if err != nil {
+                var zero T
+                yield(zero, err)
+                return
+            }
+
+            for _, item := range resp.Items {
+                if !yield(item, nil) {
+                    return
+                }
+            }
+
+            if !resp.HasNext {
+                return
+            }
+            page++
+        }
+    }
+}
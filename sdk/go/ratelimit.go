@@ -0,0 +1,72 @@
+package dotmac
+
+import (
+    "context"
+    "sync"
+    "time"
+)
+
+// RateLimit configures a client-side token-bucket rate limiter applied to
+// every outgoing request before it hits the wire. A zero value disables
+// rate limiting.
+type RateLimit struct {
+    // RequestsPerSecond is the sustained rate tokens are replenished at.
+    RequestsPerSecond float64
+    // Burst is the bucket capacity, i.e. the largest burst of requests
+    // allowed before the sustained rate applies. Defaults to 1.
+    Burst int
+}
+
+// tokenBucket is a simple client-side token-bucket limiter. It blocks the
+// caller in wait until a token becomes available or ctx is canceled.
+type tokenBucket struct {
+    mu         sync.Mutex
+    tokens     float64
+    capacity   float64
+    refillRate float64 // tokens per second
+    last       time.Time
+}
+
+func newTokenBucket(policy RateLimit) *tokenBucket {
+    capacity := float64(policy.Burst)
+    if capacity <= 0 {
+        capacity = 1
+    }
+    return &tokenBucket{
+        tokens:     capacity,
+        capacity:   capacity,
+        refillRate: policy.RequestsPerSecond,
+        last:       time.Now(),
+    }
+}
+
+// wait blocks until a token is available, refilling the bucket based on
+// elapsed time, or returns ctx.Err() if ctx is canceled first.
+func (b *tokenBucket) wait(ctx context.Context) error {
+    for {
+        b.mu.Lock()
+        now := time.Now()
+        b.tokens += now.Sub(b.last).Seconds() * b.refillRate
+        if b.tokens > b.capacity {
+            b.tokens = b.capacity
+        }
+        b.last = now
+
+        if b.tokens >= 1 {
+            b.tokens--
+            b.mu.Unlock()
+            return nil
+        }
+
+        wait := time.Duration((1 - b.tokens) / b.refillRate * float64(time.Second))
+        b.mu.Unlock()
+
+        timer := time.NewTimer(wait)
+        select {
+        case <-ctx.Done():
+            timer.Stop()
+            return ctx.Err()
+        case <-timer.C:
+        }
+    }
+}
@@ -0,0 +1,107 @@
+package dotmac
+
+import (
+    "context"
+    "encoding/json"
+    "fmt"
+    "net/http"
+
+    "github.com/go-resty/resty/v2"
+    "go.opentelemetry.io/otel/propagation"
+)
+
+// doRequest executes an HTTP request against the API and decodes a
+// successful response into T. Non-2xx responses are decoded into an
+// *APIError and returned as the error. operation identifies the call for
+// tracing/metrics/logging, e.g. "customers.get"; it has no effect on the
+// wire request.
+func doRequest[T any](ctx context.Context, c *Client, operation, method, path string, body any, opts ...func(*resty.Request)) (*T, error) {
+    ctx, end := c.startObservedRequest(ctx, operation, method, path, body)
+
+    var result T
+    apiErr := &APIError{}
+
+    req := c.http.R().
+        SetContext(ctx).
+        SetResult(&result).
+        SetError(apiErr)
+
+    if body != nil {
+        req.SetBody(body)
+    }
+    for _, opt := range opts {
+        opt(req)
+    }
+    if c.obs != nil {
+        propagation.TraceContext{}.Inject(ctx, propagation.HeaderCarrier(req.Header))
+    }
+
+    resp, err := req.Execute(method, path)
+    if err != nil {
+        end(nil, err)
+        return nil, err
+    }
+    hr := &httpResult{statusCode: resp.StatusCode()}
+    if resp.IsError() {
+        apiErr.StatusCode = resp.StatusCode()
+        if apiErr.RequestID == "" {
+            apiErr.RequestID = resp.Header().Get("X-Request-ID")
+        }
+        end(hr, apiErr)
+        return nil, apiErr
+    }
+    end(hr, nil)
+    return &result, nil
+}
+
+// doGETRequest issues a GET request and decodes the response into T.
+func doGETRequest[T any](ctx context.Context, c *Client, operation, path string, opts ...func(*resty.Request)) (*T, error) {
+    return doRequest[T](ctx, c, operation, http.MethodGet, path, nil, opts...)
+}
+
+// doPOSTRequest issues a POST request with the given body and decodes the
+// response into T.
+func doPOSTRequest[T any](ctx context.Context, c *Client, operation, path string, body any, opts ...func(*resty.Request)) (*T, error) {
+    return doRequest[T](ctx, c, operation, http.MethodPost, path, body, opts...)
+}
+
+// doPUTRequest issues a PUT request with the given body and decodes the
+// response into T.
+func doPUTRequest[T any](ctx context.Context, c *Client, operation, path string, body any, opts ...func(*resty.Request)) (*T, error) {
+    return doRequest[T](ctx, c, operation, http.MethodPut, path, body, opts...)
+}
+
+// doDELETERequest issues a DELETE request, discarding any response body.
+func doDELETERequest(ctx context.Context, c *Client, operation, path string, opts ...func(*resty.Request)) error {
+    _, err := doRequest[struct{}](ctx, c, operation, http.MethodDelete, path, nil, opts...)
+    return err
+}
+
+// doGETListRequest issues a GET request against a paginated listing
+// endpoint, applying opts.Page, opts.PageSize and opts.Filter as query
+// parameters alongside any extra request customization.
+func doGETListRequest[T any](ctx context.Context, c *Client, operation, path string, opts ListOptions, extra ...func(*resty.Request)) (*PaginatedResponse[T], error) {
+    var encodedFilter string
+    if len(opts.Filter) > 0 {
+        encoded, err := json.Marshal(opts.Filter)
+        if err != nil {
+            return nil, fmt.Errorf("dotmac: encode filter: %w", err)
+        }
+        encodedFilter = string(encoded)
+    }
+
+    return doGETRequest[PaginatedResponse[T]](ctx, c, operation, path, func(r *resty.Request) {
+        if opts.Page > 0 {
+            r.SetQueryParam("page", fmt.Sprintf("%d", opts.Page))
+        }
+        if opts.PageSize > 0 {
+            r.SetQueryParam("limit", fmt.Sprintf("%d", opts.PageSize))
+        }
+        if encodedFilter != "" {
+            r.SetQueryParam("filter", encodedFilter)
+        }
+        for _, e := range extra {
+            e(r)
+        }
+    })
+}
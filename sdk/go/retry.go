@@ -0,0 +1,102 @@
+package dotmac
+
+import (
+    "math/rand"
+    "net/http"
+    "strconv"
+    "time"
+
+    "github.com/go-resty/resty/v2"
+)
+
+// RetryBackoff configures the automatic retry middleware installed on every
+// Client. MaxRetries (on Config) controls how many attempts are made; this
+// struct controls the wait schedule between attempts.
+type RetryBackoff struct {
+    // Base is the wait time before the first retry. Defaults to 200ms.
+    Base time.Duration
+    // Max caps the wait time between retries. Defaults to 10s.
+    Max time.Duration
+    // Jitter randomizes each wait within [wait/2, wait] to avoid thundering
+    // herds across many clients retrying in lockstep.
+    Jitter bool
+    // RetriableStatuses lists HTTP status codes that should be retried.
+    // Defaults to 429, 500, 502, 503, and 504.
+    RetriableStatuses []int
+}
+
+var defaultRetriableStatuses = []int{
+    http.StatusTooManyRequests,
+    http.StatusInternalServerError,
+    http.StatusBadGateway,
+    http.StatusServiceUnavailable,
+    http.StatusGatewayTimeout,
+}
+
+// configureRetry wires MaxRetries/RetryBackoff onto httpClient: it retries
+// on network errors and on the configured status codes, honors a
+// Retry-After header on 429/503 responses, and otherwise backs off
+// exponentially between policy.Base and policy.Max.
+func configureRetry(httpClient *resty.Client, maxRetries int, policy RetryBackoff) {
+    if maxRetries <= 0 {
+        return
+    }
+    base := policy.Base
+    if base <= 0 {
+        base = 200 * time.Millisecond
+    }
+    max := policy.Max
+    if max <= 0 {
+        max = 10 * time.Second
+    }
+    statuses := policy.RetriableStatuses
+    if len(statuses) == 0 {
+        statuses = defaultRetriableStatuses
+    }
+
+    httpClient.SetRetryCount(maxRetries)
+    // resty clamps every computed wait to [RetryWaitTime, RetryMaxWaitTime],
+    // so those bounds are set from the policy rather than resty's defaults.
+    minWait := base
+    if policy.Jitter {
+        minWait = base / 2
+    }
+    httpClient.SetRetryWaitTime(minWait)
+    httpClient.SetRetryMaxWaitTime(max)
+    httpClient.AddRetryCondition(func(resp *resty.Response, err error) bool {
+        if err != nil {
+            return true
+        }
+        for _, code := range statuses {
+            if resp.StatusCode() == code {
+                return true
+            }
+        }
+        return false
+    })
+    httpClient.SetRetryAfter(func(_ *resty.Client, resp *resty.Response) (time.Duration, error) {
+        if resp != nil {
+            status := resp.StatusCode()
+            if status == http.StatusTooManyRequests || status == http.StatusServiceUnavailable {
+                if ra := resp.Header().Get("Retry-After"); ra != "" {
+                    if secs, err := strconv.Atoi(ra); err == nil {
+                        return time.Duration(secs) * time.Second, nil
+                    }
+                }
+            }
+        }
+
+        attempt := 1
+        if resp != nil && resp.Request != nil && resp.Request.Attempt > 0 {
+            attempt = resp.Request.Attempt
+        }
+        wait := base * time.Duration(uint(1)<<uint(attempt-1))
+        if wait > max {
+            wait = max
+        }
+        if policy.Jitter {
+            wait = wait/2 + time.Duration(rand.Int63n(int64(wait)/2+1))
+        }
+        return wait, nil
+    })
+}
@@ -0,0 +1,98 @@
+package dotmac
+
+import (
+    "context"
+    "fmt"
+    "iter"
+    "time"
+)
+
+// Ticket represents a support ticket
+type Ticket struct {
+    ID         string    `json:"id"`
+    CustomerID string    `json:"customer_id"`
+    Subject    string    `json:"subject"`
+    Status     string    `json:"status"`
+    Priority   string    `json:"priority"`
+    CreatedAt  time.Time `json:"created_at"`
+    UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// CreateTicketRequest represents the request to create a ticket
+type CreateTicketRequest struct {
+    CustomerID  string `json:"customer_id"`
+    Subject     string `json:"subject"`
+    Description string `json:"description"`
+    Priority    string `json:"priority,omitempty"`
+}
+
+// UpdateTicketRequest represents a partial update to a ticket.
+type UpdateTicketRequest struct {
+    Subject  string `json:"subject,omitempty"`
+    Priority string `json:"priority,omitempty"`
+}
+
+// Comment represents a comment left on a ticket.
+type Comment struct {
+    ID        string    `json:"id"`
+    TicketID  string    `json:"ticket_id"`
+    Body      string    `json:"body"`
+    AuthorID  string    `json:"author_id"`
+    CreatedAt time.Time `json:"created_at"`
+}
+
+// AddCommentRequest represents the request to add a comment to a ticket.
+type AddCommentRequest struct {
+    Body string `json:"body"`
+}
+
+// TicketService handles ticket-related operations
+type TicketService struct {
+    client *Client
+}
+
+// Create creates a new support ticket
+func (s *TicketService) Create(ctx context.Context, req CreateTicketRequest) (*Ticket, error) {
+    return doPOSTRequest[Ticket](ctx, s.client, "tickets.create", "/api/v1/tickets", req)
+}
+
+// Get retrieves a ticket by ID
+func (s *TicketService) Get(ctx context.Context, ticketID string) (*Ticket, error) {
+    return doGETRequest[Ticket](ctx, s.client, "tickets.get", fmt.Sprintf("/api/v1/tickets/%s", ticketID))
+}
+
+// List retrieves a single page of tickets, optionally filtered via opts.Filter.
+func (s *TicketService) List(ctx context.Context, opts ListOptions) (*PaginatedResponse[Ticket], error) {
+    return doGETListRequest[Ticket](ctx, s.client, "tickets.list", "/api/v1/tickets", opts)
+}
+
+// ListAll returns an iterator over every ticket matching opts, fetching
+// additional pages from the API as the iteration advances.
+func (s *TicketService) ListAll(ctx context.Context, opts ListOptions) iter.Seq2[Ticket, error] {
+    return listAll(ctx, opts, s.List)
+}
+
+// Update applies a partial update to a ticket.
+func (s *TicketService) Update(ctx context.Context, ticketID string, req UpdateTicketRequest) (*Ticket, error) {
+    return doPUTRequest[Ticket](ctx, s.client, "tickets.update", fmt.Sprintf("/api/v1/tickets/%s", ticketID), req)
+}
+
+// Delete permanently removes a ticket.
+func (s *TicketService) Delete(ctx context.Context, ticketID string) error {
+    return doDELETERequest(ctx, s.client, "tickets.delete", fmt.Sprintf("/api/v1/tickets/%s", ticketID))
+}
+
+// AddComment appends a comment to a ticket.
+func (s *TicketService) AddComment(ctx context.Context, ticketID string, req AddCommentRequest) (*Comment, error) {
+    return doPOSTRequest[Comment](ctx, s.client, "tickets.add_comment", fmt.Sprintf("/api/v1/tickets/%s/comments", ticketID), req)
+}
+
+// AssignAgent assigns a ticket to the given support agent.
+func (s *TicketService) AssignAgent(ctx context.Context, ticketID, agentID string) (*Ticket, error) {
+    return doPOSTRequest[Ticket](ctx, s.client, "tickets.assign_agent", fmt.Sprintf("/api/v1/tickets/%s/assign", ticketID), map[string]string{"agent_id": agentID})
+}
+
+// ChangeStatus transitions a ticket to the given status.
+func (s *TicketService) ChangeStatus(ctx context.Context, ticketID, status string) (*Ticket, error) {
+    return doPOSTRequest[Ticket](ctx, s.client, "tickets.change_status", fmt.Sprintf("/api/v1/tickets/%s/status", ticketID), map[string]string{"status": status})
+}
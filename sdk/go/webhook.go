@@ -0,0 +1,48 @@
+package dotmac
+
+import (
+    "context"
+    "fmt"
+    "iter"
+    "time"
+)
+
+// Webhook represents a registered outbound webhook subscription.
+type Webhook struct {
+    ID        string    `json:"id"`
+    URL       string    `json:"url"`
+    Events    []string  `json:"events"`
+    CreatedAt time.Time `json:"created_at"`
+}
+
+// RegisterWebhookRequest represents the request to register a new webhook.
+type RegisterWebhookRequest struct {
+    URL    string   `json:"url"`
+    Events []string `json:"events"`
+}
+
+// WebhookService manages outbound webhook subscriptions.
+type WebhookService struct {
+    client *Client
+}
+
+// Register creates a new webhook subscription.
+func (s *WebhookService) Register(ctx context.Context, req RegisterWebhookRequest) (*Webhook, error) {
+    return doPOSTRequest[Webhook](ctx, s.client, "webhooks.register", "/api/v1/webhooks", req)
+}
+
+// List retrieves a single page of webhook subscriptions.
+func (s *WebhookService) List(ctx context.Context, opts ListOptions) (*PaginatedResponse[Webhook], error) {
+    return doGETListRequest[Webhook](ctx, s.client, "webhooks.list", "/api/v1/webhooks", opts)
+}
+
+// ListAll returns an iterator over every webhook subscription matching opts,
+// fetching additional pages from the API as the iteration advances.
+func (s *WebhookService) ListAll(ctx context.Context, opts ListOptions) iter.Seq2[Webhook, error] {
+    return listAll(ctx, opts, s.List)
+}
+
+// Delete removes a webhook subscription.
+func (s *WebhookService) Delete(ctx context.Context, webhookID string) error {
+    return doDELETERequest(ctx, s.client, "webhooks.delete", fmt.Sprintf("/api/v1/webhooks/%s", webhookID))
+}
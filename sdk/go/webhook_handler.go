@@ -0,0 +1,100 @@
+package dotmac
+
+import (
+    "crypto/hmac"
+    "crypto/sha256"
+    "encoding/hex"
+    "encoding/json"
+    "io"
+    "net/http"
+)
+
+// WebhookEventType identifies the kind of event delivered to a registered
+// webhook.
+type WebhookEventType string
+
+const (
+    EventTicketCreated   WebhookEventType = "ticket.created"
+    EventInvoicePaid     WebhookEventType = "invoice.paid"
+    EventCustomerUpdated WebhookEventType = "customer.updated"
+)
+
+// webhookEnvelope is the outer JSON structure the API wraps every webhook
+// payload in: {"type": "...", "data": {...}}.
+type webhookEnvelope struct {
+    Type WebhookEventType `json:"type"`
+    Data json.RawMessage  `json:"data"`
+}
+
+// WebhookHandlerFuncs holds the typed callbacks WebhookHandler dispatches
+// inbound events to. Callbacks for event types the caller doesn't care about
+// may be left nil; unrecognized event types are accepted and ignored.
+type WebhookHandlerFuncs struct {
+    OnTicketCreated   func(Ticket)
+    OnInvoicePaid     func(Invoice)
+    OnCustomerUpdated func(Customer)
+}
+
+// WebhookHandler returns an http.HandlerFunc that verifies the HMAC-SHA256
+// signature of inbound requests against secret (compared against the
+// X-Dotmac-Signature header, hex-encoded), decodes the event envelope, and
+// dispatches it to the matching callback in fns. Requests with a missing or
+// invalid signature are rejected with 401.
+func WebhookHandler(secret string, fns WebhookHandlerFuncs) http.HandlerFunc {
+    return func(w http.ResponseWriter, r *http.Request) {
+        body, err := io.ReadAll(r.Body)
+        if err != nil {
+            http.Error(w, "failed to read body", http.StatusBadRequest)
+            return
+        }
+
+        if !verifyWebhookSignature(secret, body, r.Header.Get("X-Dotmac-Signature")) {
+            http.Error(w, "invalid signature", http.StatusUnauthorized)
+            return
+        }
+
+        var envelope webhookEnvelope
+        if err := json.Unmarshal(body, &envelope); err != nil {
+            http.Error(w, "invalid payload", http.StatusBadRequest)
+            return
+        }
+
+        switch envelope.Type {
+        case EventTicketCreated:
+            if fns.OnTicketCreated != nil {
+                var ticket Ticket
+                if json.Unmarshal(envelope.Data, &ticket) == nil {
+                    fns.OnTicketCreated(ticket)
+                }
+            }
+        case EventInvoicePaid:
+            if fns.OnInvoicePaid != nil {
+                var invoice Invoice
+                if json.Unmarshal(envelope.Data, &invoice) == nil {
+                    fns.OnInvoicePaid(invoice)
+                }
+            }
+        case EventCustomerUpdated:
+            if fns.OnCustomerUpdated != nil {
+                var customer Customer
+                if json.Unmarshal(envelope.Data, &customer) == nil {
+                    fns.OnCustomerUpdated(customer)
+                }
+            }
+        }
+
+        w.WriteHeader(http.StatusOK)
+    }
+}
+
+// verifyWebhookSignature reports whether signature is the hex-encoded
+// HMAC-SHA256 of body under secret.
+func verifyWebhookSignature(secret string, body []byte, signature string) bool {
+    if signature == "" {
+        return false
+    }
+    mac := hmac.New(sha256.New, []byte(secret))
+    mac.Write(body)
+    expected := hex.EncodeToString(mac.Sum(nil))
+    return hmac.Equal([]byte(signature), []byte(expected))
+}
@@ -0,0 +1,56 @@
+package dotmac
+
+import (
+    "bytes"
+    "crypto/hmac"
+    "crypto/sha256"
+    "encoding/hex"
+    "net/http"
+    "net/http/httptest"
+    "testing"
+)
+
+func signedRequest(t *testing.T, secret string, body []byte) *http.Request {
+    t.Helper()
+    mac := hmac.New(sha256.New, []byte(secret))
+    mac.Write(body)
+    sig := hex.EncodeToString(mac.Sum(nil))
+
+    req := httptest.NewRequest(http.MethodPost, "/webhooks", bytes.NewReader(body))
+    req.Header.Set("X-Dotmac-Signature", sig)
+    return req
+}
+
+func TestWebhookHandlerDispatchesTicketCreated(t *testing.T) {
+    body := []byte(`{"type":"ticket.created","data":{"id":"ticket_1","subject":"Help"}}`)
+    req := signedRequest(t, "shh", body)
+
+    var gotID string
+    handler := WebhookHandler("shh", WebhookHandlerFuncs{
+        OnTicketCreated: func(tk Ticket) { gotID = tk.ID },
+    })
+
+    rec := httptest.NewRecorder()
+    handler(rec, req)
+
+    if rec.Code != http.StatusOK {
+        t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+    }
+    if gotID != "ticket_1" {
+        t.Fatalf("dispatched ticket ID = %q, want %q", gotID, "ticket_1")
+    }
+}
+
+func TestWebhookHandlerRejectsBadSignature(t *testing.T) {
+    body := []byte(`{"type":"ticket.created","data":{}}`)
+    req := httptest.NewRequest(http.MethodPost, "/webhooks", bytes.NewReader(body))
+    req.Header.Set("X-Dotmac-Signature", "not-the-right-signature")
+
+    handler := WebhookHandler("shh", WebhookHandlerFuncs{})
+    rec := httptest.NewRecorder()
+    handler(rec, req)
+
+    if rec.Code != http.StatusUnauthorized {
+        t.Fatalf("status = %d, want %d", rec.Code, http.StatusUnauthorized)
+    }
+}